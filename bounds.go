@@ -0,0 +1,34 @@
+package geohash
+
+// DecodeWithBounds decodes hash to its center point, like Decode, but also
+// returns the half-cell error radii in degrees for latitude and longitude,
+// mirroring the lat_err/lon_err style decode signature common to other
+// geohash libraries.
+func DecodeWithBounds(hash string) (lat, lng, latErr, lngErr float64) {
+	// Decode returns the cell's southwest corner: its low bits are zero
+	// rather than offset to the cell's middle. Add the half-cell error
+	// radii below to turn that corner into the true center.
+	lat, lng = Decode(hash)
+	latBits, lngBits := bitsForLength(len(hash))
+
+	latErr = (maxLat - minLat) / float64(uint64(1)<<uint(latBits)) / 2
+	lngErr = (maxLong - minLong) / float64(uint64(1)<<uint(lngBits)) / 2
+
+	return lat + latErr, lng + lngErr, latErr, lngErr
+}
+
+// CellBox returns the bounding box of the geohash cell.
+func CellBox(hash string) (minLat, minLng, maxLat, maxLng float64) {
+	lat, lng, latErr, lngErr := DecodeWithBounds(hash)
+	return lat - latErr, lng - lngErr, lat + latErr, lng + lngErr
+}
+
+// bitsForLength returns how many of the 5*n bits in an n-character geohash
+// belong to latitude vs longitude. Bits alternate starting with longitude
+// (see interleave32Bits), so longitude gets the extra bit when 5*n is odd.
+func bitsForLength(n int) (latBits, lngBits int) {
+	total := 5 * n
+	lngBits = (total + 1) / 2
+	latBits = total / 2
+	return latBits, lngBits
+}
@@ -0,0 +1,57 @@
+package geohash
+
+import "testing"
+
+// TestCellBoxKnownCell pins down the cell for a single top-level character
+// against its known extents, independent of Decode/DecodeWithBounds: the
+// top-level grid divides (lat, lng) into 45x45 degree cells starting at
+// (-90, -180), so "s" (index 24 in the base32 alphabet: lat row 2, lng
+// column 4, counting from 0 at the southwest corner) covers lat in [0, 45)
+// and lng in [0, 45).
+func TestCellBoxKnownCell(t *testing.T) {
+	minLat, minLng, maxLat, maxLng := CellBox("s")
+
+	const tolerance = 0.01
+	if abs(minLat-0) > tolerance || abs(maxLat-45) > tolerance {
+		t.Errorf(`CellBox("s") lat = [%v, %v], want [0, 45]`, minLat, maxLat)
+	}
+	if abs(minLng-0) > tolerance || abs(maxLng-45) > tolerance {
+		t.Errorf(`CellBox("s") lng = [%v, %v], want [0, 45]`, minLng, maxLng)
+	}
+}
+
+// TestCellBoxMatchesEncode checks CellBox against Encode, an independent
+// implementation of the same grid, rather than against Decode: a point
+// taken from the middle of the reported box must re-encode to the same
+// hash, and a point just past each edge must encode to a different one.
+func TestCellBoxMatchesEncode(t *testing.T) {
+	for _, hash := range []string{"s", "u4pruy", "dqcjq"} {
+		minLat, minLng, maxLat, maxLng := CellBox(hash)
+
+		midLat, midLng := (minLat+maxLat)/2, (minLng+maxLng)/2
+		if got := Encode(midLat, midLng)[:len(hash)]; got != hash {
+			t.Errorf("Encode(midpoint of CellBox(%q)) = %q, want %q", hash, got, hash)
+		}
+
+		const epsilon = 1e-6
+		if got := Encode(maxLat+epsilon, midLng)[:len(hash)]; got == hash {
+			t.Errorf("Encode(just north of CellBox(%q)) still = %q, box is too short", hash, got)
+		}
+		if got := Encode(minLat-epsilon, midLng)[:len(hash)]; got == hash {
+			t.Errorf("Encode(just south of CellBox(%q)) still = %q, box is too short", hash, got)
+		}
+		if got := Encode(midLat, maxLng+epsilon)[:len(hash)]; got == hash {
+			t.Errorf("Encode(just east of CellBox(%q)) still = %q, box is too narrow", hash, got)
+		}
+		if got := Encode(midLat, minLng-epsilon)[:len(hash)]; got == hash {
+			t.Errorf("Encode(just west of CellBox(%q)) still = %q, box is too narrow", hash, got)
+		}
+	}
+}
+
+func abs(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
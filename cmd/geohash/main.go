@@ -4,17 +4,40 @@ import (
 	"bufio"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
 
 	"github.com/quantonganh/geohash"
+	"github.com/quantonganh/geohash/geojson"
 )
 
 const usage = `Usage of geohash:
   -d, --decode string
         Geohash for decoding
+  -i string
+        Input format: coords (default) or geojson
+  -o string
+        Output format: text (default) or geojson
+
+  geohash grid <lat,lng>
+        Encode a Maidenhead grid locator
+  geohash grid -d <locator>
+        Decode a Maidenhead grid locator
+`
+
+const gridUsage = `Usage of geohash grid:
+  geohash grid <lat,lng>
+        Encode a Maidenhead grid locator
+  geohash grid -d, --decode <locator>
+        Decode a Maidenhead grid locator
 `
 
+// gridPairs is the number of field/square/subsquare pairs encoded by
+// `geohash grid`: 3 pairs is the 6-character locator most amateur radio
+// logging software (e.g. WSJT-X) reports.
+const gridPairs = 3
+
 func main() {
 	if err := run(); err != nil {
 		log.Fatalf("%+v", err)
@@ -22,6 +45,10 @@ func main() {
 }
 
 func run() error {
+	if len(os.Args) > 1 && os.Args[1] == "grid" {
+		return runGrid(os.Args[2:])
+	}
+
 	stat, err := os.Stdin.Stat()
 	if err != nil {
 		return fmt.Errorf("error getting FileInfo structure: %w", err)
@@ -36,54 +63,134 @@ func run() error {
 			fmt.Println("Error reading stdin:", err)
 		}
 
-		if len(os.Args) == 1 {
-			hash, err := encode(input)
+		fs := flag.NewFlagSet("geohash", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		var decodeMode bool
+		var inputFormat, outputFormat string
+		fs.BoolVar(&decodeMode, "d", false, "Decode input")
+		fs.BoolVar(&decodeMode, "decode", false, "Decode input")
+		fs.StringVar(&inputFormat, "i", "coords", "Input format: coords or geojson")
+		fs.StringVar(&outputFormat, "o", "text", "Output format: text or geojson")
+
+		if err := fs.Parse(os.Args[1:]); err != nil || len(fs.Args()) > 0 {
+			fmt.Print(usage)
+			return nil
+		}
+
+		if decodeMode {
+			out, err := decodeOutput(input, outputFormat)
 			if err != nil {
-				return fmt.Errorf("error encoding: %w", err)
+				return fmt.Errorf("error decoding: %w", err)
 			}
-			fmt.Println(hash)
+			fmt.Print(out)
 		} else {
-			switch os.Args[1] {
-			case "-d", "--decode":
-				lat, lng, err := decode(input)
-				if err != nil {
-					return fmt.Errorf("error decoding: %w", err)
-				}
-				fmt.Printf("%.04f, %.04f\n", lat, lng)
-			default:
-				fmt.Print(usage)
+			hash, err := encodeInput(input, inputFormat)
+			if err != nil {
+				return fmt.Errorf("error encoding: %w", err)
 			}
+			fmt.Println(hash)
 		}
 	} else {
-		var decodeVal string
+		var decodeVal, inputFormat, outputFormat string
 		flag.StringVar(&decodeVal, "decode", "", "Geohash for decoding")
 		flag.StringVar(&decodeVal, "d", "", "Alias for --decode")
+		flag.StringVar(&inputFormat, "i", "coords", "Input format: coords or geojson")
+		flag.StringVar(&outputFormat, "o", "text", "Output format: text or geojson")
 		flag.Usage = func() { fmt.Print(usage) }
 		flag.Parse()
 
-		if len(os.Args) == 1 {
-			fmt.Print(usage)
-		} else {
-			switch os.Args[1] {
-			case "-d", "--decode":
-				lat, lng, err := decode(decodeVal)
-				if err != nil {
-					return fmt.Errorf("error decoding: %w", err)
-				}
-				fmt.Printf("%.04f, %.04f\n", lat, lng)
-			default:
-				hash, err := encode(os.Args[1])
-				if err != nil {
-					return fmt.Errorf("error encoding: %w", err)
-				}
-				fmt.Println(hash)
+		args := flag.Args()
+
+		switch {
+		case decodeVal != "":
+			out, err := decodeOutput(decodeVal, outputFormat)
+			if err != nil {
+				return fmt.Errorf("error decoding: %w", err)
 			}
+			fmt.Print(out)
+		case len(args) > 0:
+			hash, err := encodeInput(args[0], inputFormat)
+			if err != nil {
+				return fmt.Errorf("error encoding: %w", err)
+			}
+			fmt.Println(hash)
+		default:
+			fmt.Print(usage)
+		}
+	}
+
+	return nil
+}
+
+func runGrid(args []string) error {
+	fs := flag.NewFlagSet("grid", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var decodeVal string
+	fs.StringVar(&decodeVal, "d", "", "Maidenhead locator for decoding")
+	fs.StringVar(&decodeVal, "decode", "", "Alias for -d")
+
+	if err := fs.Parse(args); err != nil {
+		fmt.Print(gridUsage)
+		return nil
+	}
+
+	if decodeVal != "" {
+		lat, lng, err := geohash.DecodeMaidenhead(decodeVal)
+		if err != nil {
+			return fmt.Errorf("error decoding grid locator: %w", err)
 		}
+		fmt.Printf("%.04f, %.04f\n", lat, lng)
+		return nil
+	}
+
+	rest := fs.Args()
+	if len(rest) == 0 {
+		fmt.Print(gridUsage)
+		return nil
+	}
+
+	lat, lng, err := geohash.ParseCoordinate(rest[0])
+	if err != nil {
+		return fmt.Errorf("error parsing coordinates: %w", err)
+	}
+
+	locator, err := geohash.EncodeMaidenhead(lat, lng, gridPairs)
+	if err != nil {
+		return fmt.Errorf("error encoding grid locator: %w", err)
 	}
+	fmt.Println(locator)
 
 	return nil
 }
 
+func encodeInput(input, format string) (string, error) {
+	if format == "geojson" {
+		lat, lng, err := geojson.ParsePoint([]byte(input))
+		if err != nil {
+			return "", err
+		}
+		return geohash.Encode(lat, lng), nil
+	}
+
+	return encode(input)
+}
+
+func decodeOutput(hash, format string) (string, error) {
+	if format == "geojson" {
+		feature, err := geojson.EncodeFeature(hash)
+		if err != nil {
+			return "", err
+		}
+		return string(feature) + "\n", nil
+	}
+
+	lat, lng, err := decode(hash)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%.04f, %.04f\n", lat, lng), nil
+}
+
 func encode(coords string) (string, error) {
 	lat, lng, err := geohash.ParseCoordinate(coords)
 	if err != nil {
@@ -134,8 +134,9 @@ func Base32ToUint64(hash string) uint64 {
 		result = (result << 5) | uint64(index)
 	}
 
-	// Pad 4 zero digits to make it 64 bits
-	result <<= (64 - 5*maxLength)
+	// Left-align the consumed bits within the 64-bit value so that hashes
+	// shorter than maxLength (e.g. cell prefixes) still decode correctly.
+	result <<= uint(64 - 5*len(hash))
 
 	return result
 }
@@ -0,0 +1,100 @@
+// Package geojson parses and emits RFC 7946 GeoJSON for geohash points and
+// cells, so the geohash CLI can be piped through tools like jq or
+// tippecanoe.
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/quantonganh/geohash"
+)
+
+type genericGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+type genericFeature struct {
+	Type     string           `json:"type"`
+	Geometry *genericGeometry `json:"geometry,omitempty"`
+}
+
+type polygonGeometry struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+type polygonFeature struct {
+	Type       string          `json:"type"`
+	Geometry   polygonGeometry `json:"geometry"`
+	Properties properties      `json:"properties"`
+}
+
+type properties struct {
+	Geohash string `json:"geohash"`
+}
+
+// ParsePoint extracts the (lat, lng) encoded in a GeoJSON Point geometry, or
+// a Feature wrapping one. GeoJSON orders coordinates [lng, lat, ...] per
+// RFC 7946 section 3.1.1, the opposite of the lat,lng convention this
+// package otherwise uses.
+func ParsePoint(data []byte) (lat, lng float64, err error) {
+	var f genericFeature
+	if err = json.Unmarshal(data, &f); err != nil {
+		return 0, 0, fmt.Errorf("parsing GeoJSON: %w", err)
+	}
+
+	geom := f.Geometry
+	if f.Type == "Point" {
+		geom = &genericGeometry{}
+		if err = json.Unmarshal(data, geom); err != nil {
+			return 0, 0, fmt.Errorf("parsing GeoJSON: %w", err)
+		}
+	}
+	if geom == nil {
+		return 0, 0, fmt.Errorf("no Point geometry found")
+	}
+	if geom.Type != "Point" {
+		return 0, 0, fmt.Errorf("expected a Point geometry, got %q", geom.Type)
+	}
+
+	var coords []float64
+	if err = json.Unmarshal(geom.Coordinates, &coords); err != nil {
+		return 0, 0, fmt.Errorf("parsing Point coordinates: %w", err)
+	}
+	if len(coords) < 2 {
+		return 0, 0, fmt.Errorf("expected at least 2 Point coordinates, got %d", len(coords))
+	}
+
+	return coords[1], coords[0], nil
+}
+
+// EncodeFeature returns a GeoJSON Feature describing hash's cell as a
+// Polygon, with the geohash itself recorded under properties.geohash.
+func EncodeFeature(hash string) ([]byte, error) {
+	if err := geohash.ParseGeohash(hash); err != nil {
+		return nil, err
+	}
+
+	minLat, minLng, maxLat, maxLng := geohash.CellBox(hash)
+
+	ring := [][2]float64{
+		{minLng, minLat},
+		{maxLng, minLat},
+		{maxLng, maxLat},
+		{minLng, maxLat},
+		{minLng, minLat},
+	}
+
+	f := polygonFeature{
+		Type: "Feature",
+		Geometry: polygonGeometry{
+			Type:        "Polygon",
+			Coordinates: [][][2]float64{ring},
+		},
+		Properties: properties{Geohash: hash},
+	}
+
+	return json.Marshal(f)
+}
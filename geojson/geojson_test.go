@@ -0,0 +1,100 @@
+package geojson
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParsePointGeometry(t *testing.T) {
+	lat, lng, err := ParsePoint([]byte(`{"type":"Point","coordinates":[-122.4194,37.7749]}`))
+	if err != nil {
+		t.Fatalf("ParsePoint: %v", err)
+	}
+	if lat != 37.7749 || lng != -122.4194 {
+		t.Errorf("ParsePoint = (%v, %v), want (37.7749, -122.4194)", lat, lng)
+	}
+}
+
+func TestParsePointFeature(t *testing.T) {
+	lat, lng, err := ParsePoint([]byte(`{"type":"Feature","geometry":{"type":"Point","coordinates":[-0.1278,51.5074]},"properties":{}}`))
+	if err != nil {
+		t.Fatalf("ParsePoint: %v", err)
+	}
+	if lat != 51.5074 || lng != -0.1278 {
+		t.Errorf("ParsePoint = (%v, %v), want (51.5074, -0.1278)", lat, lng)
+	}
+}
+
+func TestParsePointWithAltitudeIgnoresExtra(t *testing.T) {
+	lat, lng, err := ParsePoint([]byte(`{"type":"Point","coordinates":[-0.1278,51.5074,35]}`))
+	if err != nil {
+		t.Fatalf("ParsePoint: %v", err)
+	}
+	if lat != 51.5074 || lng != -0.1278 {
+		t.Errorf("ParsePoint = (%v, %v), want (51.5074, -0.1278)", lat, lng)
+	}
+}
+
+func TestParsePointRejectsMissingCoordinate(t *testing.T) {
+	if _, _, err := ParsePoint([]byte(`{"type":"Point","coordinates":[-0.1278]}`)); err == nil {
+		t.Error("ParsePoint should reject a Point with only one coordinate")
+	}
+}
+
+func TestParsePointRejectsNonPointGeometry(t *testing.T) {
+	if _, _, err := ParsePoint([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`)); err == nil {
+		t.Error("ParsePoint should reject a Polygon geometry")
+	}
+}
+
+func TestParsePointRejectsMalformedJSON(t *testing.T) {
+	if _, _, err := ParsePoint([]byte(`not json`)); err == nil {
+		t.Error("ParsePoint should reject malformed JSON")
+	}
+}
+
+func TestParsePointRejectsMissingGeometry(t *testing.T) {
+	if _, _, err := ParsePoint([]byte(`{"type":"Feature","properties":{}}`)); err == nil {
+		t.Error("ParsePoint should reject a Feature with no geometry")
+	}
+}
+
+func TestEncodeFeatureRoundTrip(t *testing.T) {
+	hash := "u4pruydqqvj"
+
+	data, err := EncodeFeature(hash)
+	if err != nil {
+		t.Fatalf("EncodeFeature(%q): %v", hash, err)
+	}
+
+	var f polygonFeature
+	if err := json.Unmarshal(data, &f); err != nil {
+		t.Fatalf("unmarshalling EncodeFeature output: %v", err)
+	}
+
+	if f.Type != "Feature" {
+		t.Errorf("Feature type = %q, want \"Feature\"", f.Type)
+	}
+	if f.Geometry.Type != "Polygon" {
+		t.Errorf("geometry type = %q, want \"Polygon\"", f.Geometry.Type)
+	}
+	if f.Properties.Geohash != hash {
+		t.Errorf("properties.geohash = %q, want %q", f.Properties.Geohash, hash)
+	}
+
+	ring := f.Geometry.Coordinates[0]
+	if len(ring) != 5 || ring[0] != ring[4] {
+		t.Fatalf("expected a closed 5-point ring, got %v", ring)
+	}
+
+	lat, lng, err := ParsePoint(data)
+	if err == nil {
+		t.Fatalf("expected ParsePoint to reject a Polygon feature, got (%v, %v)", lat, lng)
+	}
+}
+
+func TestEncodeFeatureInvalidHash(t *testing.T) {
+	if _, err := EncodeFeature("invalid!"); err == nil {
+		t.Error("EncodeFeature should reject an invalid geohash")
+	}
+}
@@ -0,0 +1,44 @@
+package geohash
+
+import "encoding/binary"
+
+// EncodeInt returns the Morton-coded (Z-order) integer for (lat, lng),
+// keeping only the top bits bits of precision out of the full 64-bit code
+// produced by interleave32Bits. This mirrors Bleve/Lucene's integer geohash
+// indexing, where a single interleaved value can be truncated to whatever
+// precision a caller needs.
+func EncodeInt(lat, lng float64, bits uint) uint64 {
+	lat32 := mapTo32Bits((lat - minLat) / (maxLat - minLat))
+	lng32 := mapTo32Bits((lng - minLong) / (maxLong - minLong))
+
+	morton := interleave32Bits(lat32, lng32)
+
+	return morton >> (64 - bits)
+}
+
+// DecodeInt reverses EncodeInt, returning the (lat, lng) of the cell
+// represented by the top bits bits of v.
+func DecodeInt(v uint64, bits uint) (lat, lng float64) {
+	morton := v << (64 - bits)
+
+	lat32, lng32 := DeInterleave64Bits(morton)
+
+	lat = float64(lat32)/(1<<32)*(maxLat-minLat) + minLat
+	lng = float64(lng32)/(1<<32)*(maxLong-minLong) + minLong
+
+	return lat, lng
+}
+
+// PrefixCoded encodes v>>shift as a lexicographically sortable byte key,
+// following Lucene's NumericUtils prefix-coding scheme: the leading byte
+// records shift so that terms indexed at different precision levels never
+// collide, and the remaining bytes are the big-endian value. Storing several
+// shift levels of the same point lets range/bounding-box queries be answered
+// by intersecting term prefixes instead of decoding every candidate.
+func PrefixCoded(v uint64, shift uint) []byte {
+	buf := make([]byte, 9)
+	buf[0] = byte(shift)
+	binary.BigEndian.PutUint64(buf[1:], v>>shift)
+
+	return buf
+}
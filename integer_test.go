@@ -0,0 +1,69 @@
+package geohash
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeDecodeIntRoundTrip(t *testing.T) {
+	cases := []struct {
+		lat, lng float64
+		bits     uint
+	}{
+		{37.7749, -122.4194, 32},
+		{51.5074, -0.1278, 40},
+		{-33.8688, 151.2093, 52},
+		{0, 0, 24},
+	}
+
+	for _, c := range cases {
+		v := EncodeInt(c.lat, c.lng, c.bits)
+		lat, lng := DecodeInt(v, c.bits)
+
+		if diff := lat - c.lat; diff > 1 || diff < -1 {
+			t.Errorf("EncodeInt(%v, %v, %d): decoded lat %v too far from original", c.lat, c.lng, c.bits, lat)
+		}
+		if diff := lng - c.lng; diff > 1 || diff < -1 {
+			t.Errorf("EncodeInt(%v, %v, %d): decoded lng %v too far from original", c.lat, c.lng, c.bits, lng)
+		}
+	}
+}
+
+func TestEncodeIntHigherPrecisionIsCloser(t *testing.T) {
+	lat, lng := 48.8566, 2.3522
+
+	lowLat, lowLng := DecodeInt(EncodeInt(lat, lng, 16), 16)
+	highLat, highLng := DecodeInt(EncodeInt(lat, lng, 48), 48)
+
+	lowErr := Distance(lat, lng, lowLat, lowLng)
+	highErr := Distance(lat, lng, highLat, highLng)
+
+	if highErr >= lowErr {
+		t.Errorf("expected 48-bit precision (%v km off) to be closer than 16-bit (%v km off)", highErr, lowErr)
+	}
+}
+
+func TestPrefixCodedMonotonic(t *testing.T) {
+	const bits = 40
+	const shift = 8
+
+	lats := []float64{-60, -30, -1, 0, 1, 30, 60, 89}
+
+	var prev []byte
+	for i, lat := range lats {
+		v := EncodeInt(lat, 0, bits)
+		coded := PrefixCoded(v, shift)
+
+		if i > 0 && bytes.Compare(prev, coded) > 0 {
+			t.Errorf("PrefixCoded not monotonic: lat %v produced %x after %x", lat, coded, prev)
+		}
+		prev = coded
+	}
+}
+
+func TestPrefixCodedLength(t *testing.T) {
+	coded := PrefixCoded(EncodeInt(10, 20, 32), 4)
+	if len(coded) != 9 {
+		t.Fatalf("expected a 9-byte prefix-coded key, got %d bytes", len(coded))
+	}
+}
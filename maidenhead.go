@@ -0,0 +1,148 @@
+package geohash
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maidenheadPair describes one field/square/subsquare level of a Maidenhead
+// ("QTH") grid locator: how many cells it divides its parent cell into, and
+// the alphabet used to name them. Levels alternate letters and digits,
+// starting with the 18-division field.
+type maidenheadPair struct {
+	divisions int
+	alphabet  string
+}
+
+var maidenheadPairs = []maidenheadPair{
+	{divisions: 18, alphabet: "ABCDEFGHIJKLMNOPQR"},
+	{divisions: 10, alphabet: "0123456789"},
+	{divisions: 24, alphabet: "abcdefghijklmnopqrstuvwx"},
+	{divisions: 10, alphabet: "0123456789"},
+}
+
+// EncodeMaidenhead returns the Maidenhead grid locator for (lat, lng) with
+// the given number of field/square/subsquare pairs (2 for a 4-character
+// locator, 3 for 6, 4 for 8).
+func EncodeMaidenhead(lat, lng float64, pairs int) (string, error) {
+	if pairs < 2 || pairs > len(maidenheadPairs) {
+		return "", fmt.Errorf("pairs must be between 2 and %d", len(maidenheadPairs))
+	}
+	if lat < minLat || lat > maxLat {
+		return "", fmt.Errorf("latitude must be in the range [-90, 90]")
+	}
+	if lng < minLong || lng > maxLong {
+		return "", fmt.Errorf("longitude must be in the range [-180, 180]")
+	}
+
+	// Latitude cells are always half as tall (in degrees) as longitude
+	// cells are wide, so scaling the latitude offset by 2 lets both axes
+	// share the same division table.
+	lngOffset := lng + 180.0
+	latOffset := (lat + 90.0) * 2.0
+	cellSizeLng, cellSizeLat := 360.0, 360.0
+
+	var b strings.Builder
+	for _, p := range maidenheadPairs[:pairs] {
+		cellSizeLng /= float64(p.divisions)
+		cellSizeLat /= float64(p.divisions)
+
+		lngIdx := int(lngOffset / cellSizeLng)
+		latIdx := int(latOffset / cellSizeLat)
+		if lngIdx >= p.divisions {
+			lngIdx = p.divisions - 1
+		}
+		if latIdx >= p.divisions {
+			latIdx = p.divisions - 1
+		}
+
+		b.WriteByte(p.alphabet[lngIdx])
+		b.WriteByte(p.alphabet[latIdx])
+
+		lngOffset -= float64(lngIdx) * cellSizeLng
+		latOffset -= float64(latIdx) * cellSizeLat
+	}
+
+	return b.String(), nil
+}
+
+// DecodeMaidenhead returns the center point of the cell referenced by grid.
+func DecodeMaidenhead(grid string) (lat, lng float64, err error) {
+	lngOffset, latOffset, cellSizeLng, cellSizeLat, err := maidenheadCell(grid)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lng = lngOffset + cellSizeLng/2 - 180.0
+	lat = (latOffset+cellSizeLat/2)/2.0 - 90.0
+
+	return lat, lng, nil
+}
+
+// MaidenheadBox returns the bounding box of the cell referenced by grid,
+// mirroring CellBox.
+func MaidenheadBox(grid string) (minLat, minLng, maxLat, maxLng float64, err error) {
+	lngOffset, latOffset, cellSizeLng, cellSizeLat, err := maidenheadCell(grid)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	minLng = lngOffset - 180.0
+	maxLng = minLng + cellSizeLng
+	minLat = latOffset/2.0 - 90.0
+	maxLat = minLat + cellSizeLat/2.0
+
+	return minLat, minLng, maxLat, maxLng, nil
+}
+
+// maidenheadCell walks grid through the field/square/subsquare tables,
+// returning the offset of the cell's lower-left corner (in the same
+// lng+180/(lat+90)*2 space used by EncodeMaidenhead) along with its size.
+func maidenheadCell(grid string) (lngOffset, latOffset, cellSizeLng, cellSizeLat float64, err error) {
+	if len(grid) < 4 || len(grid)%2 != 0 {
+		return 0, 0, 0, 0, fmt.Errorf("invalid Maidenhead locator length %d", len(grid))
+	}
+
+	pairs := len(grid) / 2
+	if pairs > len(maidenheadPairs) {
+		return 0, 0, 0, 0, fmt.Errorf("locator %q is too precise", grid)
+	}
+
+	cellSizeLng, cellSizeLat = 360.0, 360.0
+
+	for i := 0; i < pairs; i++ {
+		p := maidenheadPairs[i]
+		cellSizeLng /= float64(p.divisions)
+		cellSizeLat /= float64(p.divisions)
+
+		lngIdx := indexFold(p.alphabet, grid[i*2])
+		latIdx := indexFold(p.alphabet, grid[i*2+1])
+		if lngIdx == -1 || latIdx == -1 {
+			return 0, 0, 0, 0, fmt.Errorf("invalid character in locator %q", grid)
+		}
+
+		lngOffset += float64(lngIdx) * cellSizeLng
+		latOffset += float64(latIdx) * cellSizeLat
+	}
+
+	return lngOffset, latOffset, cellSizeLng, cellSizeLat, nil
+}
+
+// indexFold is strings.IndexByte with case-insensitive letter matching, so
+// locators can be decoded regardless of the case used to type them.
+func indexFold(alphabet string, ch byte) int {
+	for i := 0; i < len(alphabet); i++ {
+		a := alphabet[i]
+		if a == ch {
+			return i
+		}
+		if 'a' <= a && a <= 'z' && ch == a-('a'-'A') {
+			return i
+		}
+		if 'A' <= a && a <= 'Z' && ch == a+('a'-'A') {
+			return i
+		}
+	}
+
+	return -1
+}
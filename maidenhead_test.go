@@ -0,0 +1,104 @@
+package geohash
+
+import "testing"
+
+func TestEncodeDecodeMaidenheadRoundTrip(t *testing.T) {
+	cases := []struct {
+		lat, lng float64
+		pairs    int
+	}{
+		{51.5074, -0.1278, 3}, // IO91wm, the London grid square
+		{37.7749, -122.4194, 2},
+		{-33.8688, 151.2093, 4},
+		{0, 0, 2},
+	}
+
+	for _, c := range cases {
+		grid, err := EncodeMaidenhead(c.lat, c.lng, c.pairs)
+		if err != nil {
+			t.Fatalf("EncodeMaidenhead(%v, %v, %d): %v", c.lat, c.lng, c.pairs, err)
+		}
+		if len(grid) != c.pairs*2 {
+			t.Errorf("EncodeMaidenhead(%v, %v, %d) = %q, want length %d", c.lat, c.lng, c.pairs, grid, c.pairs*2)
+		}
+
+		lat, lng, err := DecodeMaidenhead(grid)
+		if err != nil {
+			t.Fatalf("DecodeMaidenhead(%q): %v", grid, err)
+		}
+
+		minLat, minLng, maxLat, maxLng, err := MaidenheadBox(grid)
+		if err != nil {
+			t.Fatalf("MaidenheadBox(%q): %v", grid, err)
+		}
+		if lat < minLat || lat > maxLat || lng < minLng || lng > maxLng {
+			t.Errorf("DecodeMaidenhead(%q) center (%v, %v) outside MaidenheadBox [%v,%v,%v,%v]", grid, lat, lng, minLat, minLng, maxLat, maxLng)
+		}
+
+		if diff := lat - c.lat; diff > 1 || diff < -1 {
+			t.Errorf("EncodeMaidenhead(%v, %v, %d): decoded lat %v too far from original", c.lat, c.lng, c.pairs, lat)
+		}
+		if diff := lng - c.lng; diff > 1 || diff < -1 {
+			t.Errorf("EncodeMaidenhead(%v, %v, %d): decoded lng %v too far from original", c.lat, c.lng, c.pairs, lng)
+		}
+	}
+}
+
+func TestDecodeMaidenheadKnownLocator(t *testing.T) {
+	lat, lng, err := DecodeMaidenhead("IO91wm")
+	if err != nil {
+		t.Fatalf("DecodeMaidenhead(\"IO91wm\"): %v", err)
+	}
+
+	// IO91wm is a grid square over London; the cell center should land
+	// well within the city, not merely somewhere in the UK.
+	if lat < 51 || lat > 52 || lng < -1 || lng > 0.5 {
+		t.Errorf("DecodeMaidenhead(\"IO91wm\") = (%v, %v), want roughly (51.5, -0.1)", lat, lng)
+	}
+}
+
+func TestDecodeMaidenheadCaseInsensitive(t *testing.T) {
+	lowerLat, lowerLng, err := DecodeMaidenhead("io91wm")
+	if err != nil {
+		t.Fatalf("DecodeMaidenhead(\"io91wm\"): %v", err)
+	}
+
+	upperLat, upperLng, err := DecodeMaidenhead("IO91WM")
+	if err != nil {
+		t.Fatalf("DecodeMaidenhead(\"IO91WM\"): %v", err)
+	}
+
+	if lowerLat != upperLat || lowerLng != upperLng {
+		t.Errorf("locator decoding should be case-insensitive: got (%v,%v) vs (%v,%v)", lowerLat, lowerLng, upperLat, upperLng)
+	}
+}
+
+func TestEncodeMaidenheadInvalidInput(t *testing.T) {
+	if _, err := EncodeMaidenhead(91, 0, 2); err == nil {
+		t.Error("EncodeMaidenhead should reject latitude out of range")
+	}
+	if _, err := EncodeMaidenhead(0, 181, 2); err == nil {
+		t.Error("EncodeMaidenhead should reject longitude out of range")
+	}
+	if _, err := EncodeMaidenhead(0, 0, 1); err == nil {
+		t.Error("EncodeMaidenhead should reject fewer than 2 pairs")
+	}
+	if _, err := EncodeMaidenhead(0, 0, 5); err == nil {
+		t.Error("EncodeMaidenhead should reject more pairs than supported")
+	}
+}
+
+func TestDecodeMaidenheadInvalidInput(t *testing.T) {
+	if _, _, err := DecodeMaidenhead("IO9"); err == nil {
+		t.Error("DecodeMaidenhead should reject an odd-length locator")
+	}
+	if _, _, err := DecodeMaidenhead("IO"); err == nil {
+		t.Error("DecodeMaidenhead should reject a locator shorter than 4 characters")
+	}
+	if _, _, err := DecodeMaidenhead("IO91wm12ab"); err == nil {
+		t.Error("DecodeMaidenhead should reject a locator more precise than supported")
+	}
+	if _, _, err := DecodeMaidenhead("IO9!"); err == nil {
+		t.Error("DecodeMaidenhead should reject an invalid character")
+	}
+}
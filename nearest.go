@@ -0,0 +1,104 @@
+package geohash
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// Point is a named location used by the distance-based query helpers below.
+type Point struct {
+	ID       string
+	Lat, Lng float64
+}
+
+// SortByDistance sorts pts in place by increasing Haversine distance from
+// origin.
+func SortByDistance(origin Point, pts []Point) {
+	dist := make([]float64, len(pts))
+	for i, p := range pts {
+		dist[i] = Distance(origin.Lat, origin.Lng, p.Lat, p.Lng)
+	}
+
+	sort.Sort(&byDistance{pts: pts, dist: dist})
+}
+
+type byDistance struct {
+	pts  []Point
+	dist []float64
+}
+
+func (b *byDistance) Len() int           { return len(b.pts) }
+func (b *byDistance) Less(i, j int) bool { return b.dist[i] < b.dist[j] }
+func (b *byDistance) Swap(i, j int) {
+	b.pts[i], b.pts[j] = b.pts[j], b.pts[i]
+	b.dist[i], b.dist[j] = b.dist[j], b.dist[i]
+}
+
+// KNearest returns the k points closest to origin, nearest first. It keeps a
+// bounded max-heap of size k keyed on distance from origin, so finding the k
+// nearest among n points costs O(n log k) rather than sorting all n.
+func KNearest(origin Point, pts []Point, k int) []Point {
+	if k <= 0 {
+		return nil
+	}
+
+	h := &pointHeap{}
+	for _, p := range pts {
+		d := Distance(origin.Lat, origin.Lng, p.Lat, p.Lng)
+		if h.Len() < k {
+			heap.Push(h, pointDist{point: p, dist: d})
+		} else if d < (*h)[0].dist {
+			heap.Pop(h)
+			heap.Push(h, pointDist{point: p, dist: d})
+		}
+	}
+
+	result := make([]Point, h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(h).(pointDist).point
+	}
+
+	return result
+}
+
+// NearestWithin returns every point within radiusKm of origin, sorted by
+// increasing distance. It first discards points outside origin's
+// BoundingBox before paying for the full Haversine Distance calculation.
+func NearestWithin(origin Point, pts []Point, radiusKm float64) []Point {
+	minLat, minLng, maxLat, maxLng := BoundingBox(origin.Lat, origin.Lng, radiusKm)
+
+	var candidates []Point
+	for _, p := range pts {
+		if p.Lat < minLat || p.Lat > maxLat || p.Lng < minLng || p.Lng > maxLng {
+			continue
+		}
+		if Distance(origin.Lat, origin.Lng, p.Lat, p.Lng) <= radiusKm {
+			candidates = append(candidates, p)
+		}
+	}
+
+	SortByDistance(origin, candidates)
+
+	return candidates
+}
+
+type pointDist struct {
+	point Point
+	dist  float64
+}
+
+// pointHeap is a max-heap by distance, so the farthest of the k points kept
+// so far is always at the root and can be evicted in O(log k).
+type pointHeap []pointDist
+
+func (h pointHeap) Len() int            { return len(h) }
+func (h pointHeap) Less(i, j int) bool  { return h[i].dist > h[j].dist }
+func (h pointHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *pointHeap) Push(x interface{}) { *h = append(*h, x.(pointDist)) }
+func (h *pointHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
@@ -0,0 +1,59 @@
+package geohash
+
+import "testing"
+
+func testPoints() (Point, []Point) {
+	origin := Point{ID: "origin", Lat: 0, Lng: 0}
+	pts := []Point{
+		{ID: "far", Lat: 10, Lng: 10},
+		{ID: "near", Lat: 0.01, Lng: 0.01},
+		{ID: "mid", Lat: 1, Lng: 1},
+		{ID: "farthest", Lat: -20, Lng: -20},
+	}
+	return origin, pts
+}
+
+func TestSortByDistance(t *testing.T) {
+	origin, pts := testPoints()
+	SortByDistance(origin, pts)
+
+	want := []string{"near", "mid", "far", "farthest"}
+	for i, id := range want {
+		if pts[i].ID != id {
+			t.Errorf("position %d: got %s, want %s", i, pts[i].ID, id)
+		}
+	}
+}
+
+func TestKNearest(t *testing.T) {
+	origin, pts := testPoints()
+
+	got := KNearest(origin, pts, 2)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 points, got %d", len(got))
+	}
+	if got[0].ID != "near" || got[1].ID != "mid" {
+		t.Errorf("got %v, want [near mid]", got)
+	}
+}
+
+func TestKNearestKGreaterThanLen(t *testing.T) {
+	origin, pts := testPoints()
+
+	got := KNearest(origin, pts, 10)
+	if len(got) != len(pts) {
+		t.Fatalf("expected all %d points, got %d", len(pts), len(got))
+	}
+}
+
+func TestNearestWithin(t *testing.T) {
+	origin, pts := testPoints()
+
+	got := NearestWithin(origin, pts, 200)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 points within 200km, got %d: %v", len(got), got)
+	}
+	if got[0].ID != "near" || got[1].ID != "mid" {
+		t.Errorf("got %v, want [near mid]", got)
+	}
+}
@@ -0,0 +1,182 @@
+package geohash
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Direction identifies one of the eight cells adjacent to a geohash cell.
+type Direction string
+
+const (
+	North     Direction = "n"
+	NorthEast Direction = "ne"
+	East      Direction = "e"
+	SouthEast Direction = "se"
+	South     Direction = "s"
+	SouthWest Direction = "sw"
+	West      Direction = "w"
+	NorthWest Direction = "nw"
+)
+
+var allDirections = []Direction{North, NorthEast, East, SouthEast, South, SouthWest, West, NorthWest}
+
+// neighborRow holds a lookup string for a cardinal direction, split by the
+// parity of the hash length: geohash characters alternate which axis (lng or
+// lat) their bits belong to, so the borders-and-neighbors tables differ for
+// even- and odd-length hashes.
+type neighborRow struct {
+	even string
+	odd  string
+}
+
+func (r neighborRow) forLength(n int) string {
+	if n%2 == 0 {
+		return r.even
+	}
+	return r.odd
+}
+
+// Neighbor and border lookup tables for the four cardinal
+// directions, as described in https://en.wikipedia.org/wiki/Geohash#Adjacent
+// and used by most geohash implementations. Diagonal directions are derived
+// by composing two cardinal moves.
+var (
+	neighborLookup = map[Direction]neighborRow{
+		North: {even: "p0r21436x8zb9dcf5h7kjnmqesgutwvy", odd: "bc01fg45238967deuvhjyznpkmstqrwx"},
+		South: {even: "14365h7k9dcfesgujnmqp0r2twvyx8zb", odd: "238967debc01fg45kmstqrwxuvhjyznp"},
+		East:  {even: "bc01fg45238967deuvhjyznpkmstqrwx", odd: "p0r21436x8zb9dcf5h7kjnmqesgutwvy"},
+		West:  {even: "238967debc01fg45kmstqrwxuvhjyznp", odd: "14365h7k9dcfesgujnmqp0r2twvyx8zb"},
+	}
+	borderLookup = map[Direction]neighborRow{
+		North: {even: "prxz", odd: "bcfguvyz"},
+		South: {even: "028b", odd: "0145hjnp"},
+		East:  {even: "bcfguvyz", odd: "prxz"},
+		West:  {even: "0145hjnp", odd: "028b"},
+	}
+)
+
+// Neighbor returns the geohash of the cell adjacent to hash in the given
+// direction. Wrap-around at the +-180 degree meridian falls out of the
+// lookup tables themselves, since the top-level geohash grid is already a
+// closed ring around the earth. There is no such wrap-around at the poles:
+// asking for the North neighbor of a cell on the top row (or South of one
+// on the bottom row) returns an error instead of silently jumping to the
+// opposite pole.
+func Neighbor(hash string, direction Direction) (string, error) {
+	if hash == "" {
+		return "", fmt.Errorf("geohash must not be empty")
+	}
+	if err := ParseGeohash(hash); err != nil {
+		return "", err
+	}
+
+	switch direction {
+	case North, South, East, West:
+		return adjacent(hash, direction)
+	case NorthEast:
+		return diagonal(hash, North, East)
+	case SouthEast:
+		return diagonal(hash, South, East)
+	case SouthWest:
+		return diagonal(hash, South, West)
+	case NorthWest:
+		return diagonal(hash, North, West)
+	default:
+		return "", fmt.Errorf("unknown direction %q", direction)
+	}
+}
+
+// Neighbors returns all eight geohashes adjacent to hash, keyed by
+// direction.
+func Neighbors(hash string) (map[Direction]string, error) {
+	result := make(map[Direction]string, len(allDirections))
+	for _, dir := range allDirections {
+		n, err := Neighbor(hash, dir)
+		if err != nil {
+			return nil, err
+		}
+		result[dir] = n
+	}
+
+	return result, nil
+}
+
+// Cover returns a set of geohashes suitable for a prefix-matching proximity
+// search around (lat, lng): the target cell sized to radiusKm via
+// EstimateLengthRequired, plus every one of its eight neighbors whose
+// bounding box intersects the query circle's bounding box. A neighbor that
+// doesn't exist (e.g. the query sits at a pole) is skipped rather than
+// dropping the whole neighbor set.
+func Cover(lat, lng, radiusKm float64) []string {
+	if radiusKm <= 0 {
+		radiusKm = 0
+	}
+
+	length := EstimateLengthRequired(radiusKm)
+	center := Encode(lat, lng)[:length]
+
+	cells := []string{center}
+
+	queryMinLat, queryMinLng, queryMaxLat, queryMaxLng := BoundingBox(lat, lng, radiusKm)
+
+	for _, dir := range allDirections {
+		hash, err := Neighbor(center, dir)
+		if err != nil {
+			continue
+		}
+
+		cellMinLat, cellMinLng, cellMaxLat, cellMaxLng := CellBox(hash)
+		if boxesIntersect(
+			queryMinLat, queryMinLng, queryMaxLat, queryMaxLng,
+			cellMinLat, cellMinLng, cellMaxLat, cellMaxLng,
+		) {
+			cells = append(cells, hash)
+		}
+	}
+
+	return cells
+}
+
+func adjacent(hash string, direction Direction) (string, error) {
+	lastCh := hash[len(hash)-1]
+	parent := hash[:len(hash)-1]
+
+	if strings.IndexByte(borderLookup[direction].forLength(len(hash)), lastCh) != -1 {
+		if parent == "" {
+			// A single remaining character on a north/south border means
+			// we are on the top or bottom row of the top-level grid: there
+			// is no cell further north/south, unlike east/west where the
+			// grid wraps around the antimeridian.
+			if direction == North || direction == South {
+				return "", fmt.Errorf("geohash %q has no neighbor to the %s: already at the pole", hash, direction)
+			}
+		} else {
+			var err error
+			parent, err = adjacent(parent, direction)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	idx := strings.IndexByte(neighborLookup[direction].forLength(len(hash)), lastCh)
+	if idx == -1 {
+		return "", fmt.Errorf("invalid character %q in geohash", lastCh)
+	}
+
+	return parent + string(alphabet[idx]), nil
+}
+
+func diagonal(hash string, first, second Direction) (string, error) {
+	h, err := adjacent(hash, first)
+	if err != nil {
+		return "", err
+	}
+
+	return adjacent(h, second)
+}
+
+func boxesIntersect(aMinLat, aMinLng, aMaxLat, aMaxLng, bMinLat, bMinLng, bMaxLat, bMaxLng float64) bool {
+	return aMinLat <= bMaxLat && aMaxLat >= bMinLat && aMinLng <= bMaxLng && aMaxLng >= bMinLng
+}
@@ -0,0 +1,165 @@
+package geohash
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNeighborsCardinalDirections(t *testing.T) {
+	hash := "u4pruy" // "y" is on its parent's east border, so East also exercises the border recursion
+	lat, lng := Decode(hash)
+	_, _, latErr, lngErr := DecodeWithBounds(hash)
+
+	neighbors, err := Neighbors(hash)
+	if err != nil {
+		t.Fatalf("Neighbors(%q): %v", hash, err)
+	}
+
+	for dir, want := range map[Direction][2]float64{
+		North: {lat + 2*latErr, lng},
+		South: {lat - 2*latErr, lng},
+		East:  {lat, lng + 2*lngErr},
+		West:  {lat, lng - 2*lngErr},
+	} {
+		n, ok := neighbors[dir]
+		if !ok {
+			t.Fatalf("Neighbors(%q) missing direction %q", hash, dir)
+		}
+		nLat, nLng := Decode(n)
+		if diff := nLat - want[0]; diff > latErr || diff < -latErr {
+			t.Errorf("%s neighbor of %q: lat %v, want near %v", dir, hash, nLat, want[0])
+		}
+		if diff := nLng - want[1]; diff > lngErr || diff < -lngErr {
+			t.Errorf("%s neighbor of %q: lng %v, want near %v", dir, hash, nLng, want[1])
+		}
+	}
+}
+
+func TestNeighborDiagonalComposesCardinals(t *testing.T) {
+	hash := "u4pruy"
+
+	ne, err := Neighbor(hash, NorthEast)
+	if err != nil {
+		t.Fatalf("Neighbor(%q, NorthEast): %v", hash, err)
+	}
+
+	n, err := Neighbor(hash, North)
+	if err != nil {
+		t.Fatalf("Neighbor(%q, North): %v", hash, err)
+	}
+	want, err := Neighbor(n, East)
+	if err != nil {
+		t.Fatalf("Neighbor(%q, East): %v", n, err)
+	}
+
+	if ne != want {
+		t.Errorf("NorthEast of %q = %q, want %q (North then East)", hash, ne, want)
+	}
+}
+
+func TestNeighborAntimeridianWrap(t *testing.T) {
+	east, err := Neighbor("z", East)
+	if err != nil {
+		t.Fatalf("Neighbor(\"z\", East): %v", err)
+	}
+	if east != "b" {
+		t.Errorf(`Neighbor("z", East) = %q, want "b"`, east)
+	}
+
+	west, err := Neighbor("0", West)
+	if err != nil {
+		t.Fatalf("Neighbor(\"0\", West): %v", err)
+	}
+	if west != "p" {
+		t.Errorf(`Neighbor("0", West) = %q, want "p"`, west)
+	}
+}
+
+func TestNeighborPoleReturnsError(t *testing.T) {
+	if _, err := Neighbor("b", North); err == nil {
+		t.Error(`Neighbor("b", North) should error: "b" is on the top row of the grid`)
+	}
+
+	if _, err := Neighbor("0", South); err == nil {
+		t.Error(`Neighbor("0", South) should error: "0" is on the bottom row of the grid`)
+	}
+
+	// A longer hash whose border recursion bottoms out on the top-level
+	// north border should still propagate the pole error instead of
+	// wrapping to the south pole (the bug this test guards against).
+	if _, err := Neighbor("bp", North); err == nil {
+		t.Error(`Neighbor("bp", North) should error: its top-level cell "b" is on the north border`)
+	}
+}
+
+func TestNeighborInvalidInput(t *testing.T) {
+	if _, err := Neighbor("", North); err == nil {
+		t.Error("Neighbor(\"\", North) should error on empty input")
+	}
+	if _, err := Neighbor("abc", North); err == nil {
+		t.Error(`Neighbor("abc", North) should error: "a" is not in the geohash alphabet`)
+	}
+	if _, err := Neighbor("u4pruy", "nowhere"); err == nil {
+		t.Error(`Neighbor with an unknown direction should error`)
+	}
+}
+
+func TestCover(t *testing.T) {
+	lat, lng := 37.7749, -122.4194
+
+	cells := Cover(lat, lng, 1)
+	if len(cells) == 0 {
+		t.Fatal("Cover returned no cells")
+	}
+
+	center := cells[0]
+	wantLength := EstimateLengthRequired(1)
+	if len(center) != wantLength {
+		t.Errorf("Cover center cell length = %d, want %d", len(center), wantLength)
+	}
+
+	seen := make(map[string]bool)
+	for _, c := range cells {
+		if seen[c] {
+			t.Errorf("Cover returned duplicate cell %q", c)
+		}
+		seen[c] = true
+
+		minLat, minLng, maxLat, maxLng := CellBox(c)
+		qMinLat, qMinLng, qMaxLat, qMaxLng := BoundingBox(lat, lng, 1)
+		if !boxesIntersect(qMinLat, qMinLng, qMaxLat, qMaxLng, minLat, minLng, maxLat, maxLng) {
+			t.Errorf("Cover returned cell %q whose box does not intersect the query circle's box", c)
+		}
+	}
+
+	// A bigger query radius should still only return cells from the
+	// target and its eight immediate neighbors.
+	bigger := Cover(lat, lng, 50)
+	if len(bigger) == 0 || len(bigger) > 9 {
+		t.Errorf("Cover(50km) returned %d cells, want between 1 and 9", len(bigger))
+	}
+}
+
+func TestCoverClampsNonPositiveRadius(t *testing.T) {
+	done := make(chan []string, 1)
+	go func() { done <- Cover(37.7749, -122.4194, -5) }()
+
+	select {
+	case cells := <-done:
+		if len(cells) == 0 {
+			t.Error("Cover with a negative radius returned no cells")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Cover(-5) did not return: EstimateLengthRequired's doubling loop never reaches a negative radius past mercatorMax")
+	}
+}
+
+func TestCoverNearPoleSkipsMissingNeighbors(t *testing.T) {
+	// At 89.9 latitude, the North and diagonal neighbors may not exist;
+	// Cover should still return the center and whichever neighbors do,
+	// instead of dropping the whole neighbor set.
+	cells := Cover(89.9, 0, 10)
+	if len(cells) == 0 {
+		t.Fatal("Cover near the pole returned no cells")
+	}
+}